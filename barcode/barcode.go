@@ -0,0 +1,168 @@
+// Package barcode renders an ISBN as an EAN-13 barcode, following ISO/IEC 15420.
+package barcode
+
+import (
+	"errors"
+	"image"
+	"image/draw"
+	"strings"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+
+	"github.com/lukasaron/isbn"
+)
+
+const (
+	startGuard  = "101"
+	centerGuard = "01010"
+	endGuard    = "101"
+)
+
+// lCodes, gCodes and rCodes are the EAN-13 7-module encodings of digits 0-9 for the left-odd
+// (L), left-even (G) and right (R) positions.
+var (
+	lCodes = [10]string{
+		"0001101", "0011001", "0010011", "0111101", "0100011",
+		"0110001", "0101111", "0111011", "0110111", "0001011",
+	}
+	gCodes = [10]string{
+		"0100111", "0110011", "0011011", "0100001", "0011101",
+		"0111001", "0000101", "0010001", "0001001", "0010111",
+	}
+	rCodes = [10]string{
+		"1110010", "1100110", "1101100", "1000010", "1011100",
+		"1001110", "1010000", "1000100", "1001000", "1110100",
+	}
+)
+
+// parityPatterns is indexed by an ISBN-13's leading digit (always "9" for books, but EAN-13
+// is defined for all ten) and gives the L/G pattern used to encode the six digits that
+// follow it - this is how that leading digit itself ends up represented in the bars.
+var parityPatterns = [10]string{
+	"LLLLLL", "LLGLGG", "LLGGLG", "LLGGGL", "LGLLGG",
+	"LGGLLG", "LGGGLL", "LGLGLG", "LGLGGL", "LGGLGL",
+}
+
+var errNotVersion13 = errors.New("barcode: ISBN must be version 13, call isbn.Normalize first")
+
+// Encode function returns the raw EAN-13 bar pattern for code: true for a black bar module,
+// false for a space. code must already be version 13 (call Normalize first), since EAN-13
+// has no representation for the version-10 format.
+func Encode(code isbn.ISBN) ([]bool, error) {
+	if code.Version() != isbn.Version13 {
+		return nil, errNotVersion13
+	}
+
+	digits := code.BarCode()
+	if len(digits) != 13 {
+		return nil, errNotVersion13
+	}
+
+	parity := parityPatterns[digits[0]-'0']
+
+	var bits strings.Builder
+	bits.WriteString(startGuard)
+
+	for i := 0; i < 6; i++ {
+		d := digits[1+i] - '0'
+		if parity[i] == 'L' {
+			bits.WriteString(lCodes[d])
+		} else {
+			bits.WriteString(gCodes[d])
+		}
+	}
+
+	bits.WriteString(centerGuard)
+
+	for i := 0; i < 6; i++ {
+		bits.WriteString(rCodes[digits[7+i]-'0'])
+	}
+
+	bits.WriteString(endGuard)
+
+	pattern := make([]bool, bits.Len())
+	for i, r := range bits.String() {
+		pattern[i] = r == '1'
+	}
+
+	return pattern, nil
+}
+
+// Options controls how Render draws a barcode image.
+type Options struct {
+	// ModuleWidth is the pixel width of a single barcode module. Defaults to 2.
+	ModuleWidth int
+	// Height is the pixel height of the bars. Defaults to 80.
+	Height int
+	// QuietZone is the pixel width of the blank margin on either side of the bars.
+	// Defaults to 9 modules, the minimum required by the EAN-13 specification.
+	QuietZone int
+	// ShowText draws the human-readable digits below the bars.
+	ShowText bool
+}
+
+const (
+	defaultModuleWidth     = 2
+	defaultHeight          = 80
+	defaultQuietZoneModule = 9
+	textPadding            = 4
+)
+
+func (o Options) withDefaults() Options {
+	if o.ModuleWidth <= 0 {
+		o.ModuleWidth = defaultModuleWidth
+	}
+
+	if o.Height <= 0 {
+		o.Height = defaultHeight
+	}
+
+	if o.QuietZone <= 0 {
+		o.QuietZone = defaultQuietZoneModule * o.ModuleWidth
+	}
+
+	return o
+}
+
+// Render function draws code as a print-ready EAN-13 barcode image according to opts. code
+// must already be version 13, the same requirement as Encode.
+func Render(code isbn.ISBN, opts Options) (image.Image, error) {
+	pattern, err := Encode(code)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = opts.withDefaults()
+
+	width := opts.QuietZone*2 + len(pattern)*opts.ModuleWidth
+	height := opts.Height
+	if opts.ShowText {
+		height += basicfont.Face7x13.Height + textPadding
+	}
+
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+
+	for i, bar := range pattern {
+		if !bar {
+			continue
+		}
+
+		x := opts.QuietZone + i*opts.ModuleWidth
+		draw.Draw(img, image.Rect(x, 0, x+opts.ModuleWidth, opts.Height), image.Black, image.Point{}, draw.Src)
+	}
+
+	if opts.ShowText {
+		drawer := &font.Drawer{
+			Dst:  img,
+			Src:  image.Black,
+			Face: basicfont.Face7x13,
+			Dot:  fixed.P(opts.QuietZone, opts.Height+basicfont.Face7x13.Height),
+		}
+		drawer.DrawString(code.BarCode())
+	}
+
+	return img, nil
+}
@@ -0,0 +1,71 @@
+package barcode
+
+import (
+	"testing"
+
+	"github.com/lukasaron/isbn"
+)
+
+func TestEncode(t *testing.T) {
+	code := isbn.NewISBN("978-0-7777-7777-0")
+
+	pattern, err := Encode(code)
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if len(pattern) != 95 {
+		t.Errorf("expected 95 modules, got: %v", len(pattern))
+	}
+
+	if !stringsOfBars(pattern[:len(startGuard)]) {
+		t.Error("expected the bar pattern to start with the start guard")
+	}
+}
+
+func TestEncode_RejectsVersion10(t *testing.T) {
+	code := isbn.NewISBN("0-393-04002-X")
+
+	if _, err := Encode(code); err == nil {
+		t.Error("expected an error encoding a version 10 ISBN")
+	}
+}
+
+func TestRender(t *testing.T) {
+	code := isbn.NewISBN("978-0-7777-7777-0")
+
+	img, err := Render(code, Options{})
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	bounds := img.Bounds()
+	wantWidth := defaultQuietZoneModule*defaultModuleWidth*2 + 95*defaultModuleWidth
+	if bounds.Dx() != wantWidth {
+		t.Errorf("expected width: %v, got: %v", wantWidth, bounds.Dx())
+	}
+
+	if bounds.Dy() != defaultHeight {
+		t.Errorf("expected height: %v, got: %v", defaultHeight, bounds.Dy())
+	}
+
+	imgWithText, err := Render(code, Options{ShowText: true})
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if imgWithText.Bounds().Dy() <= bounds.Dy() {
+		t.Error("expected ShowText to increase the image height")
+	}
+}
+
+func stringsOfBars(bars []bool) bool {
+	for i, bar := range bars {
+		want := startGuard[i] == '1'
+		if bar != want {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,71 @@
+package isbn
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalText method implements encoding.TextMarshaler, producing the canonical hyphenated
+// ISBN-13 form (i.e. isbn normalized via Normalize, then formatted with String).
+func (isbn ISBN) MarshalText() ([]byte, error) {
+	clone := isbn.Clone()
+	clone.Normalize()
+	if clone.err != nil {
+		return nil, clone.err
+	}
+
+	return []byte(clone.String()), nil
+}
+
+// UnmarshalText method implements encoding.TextUnmarshaler by delegating to NewISBN.
+func (isbn *ISBN) UnmarshalText(text []byte) error {
+	*isbn = NewISBN(string(text))
+	return isbn.Error()
+}
+
+// MarshalJSON method implements json.Marshaler, producing the canonical hyphenated
+// ISBN-13 form as a JSON string.
+func (isbn ISBN) MarshalJSON() ([]byte, error) {
+	text, err := isbn.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON method implements json.Unmarshaler by delegating to NewISBN.
+func (isbn *ISBN) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+
+	return isbn.UnmarshalText([]byte(text))
+}
+
+// Value method implements driver.Valuer, storing the canonical hyphenated ISBN-13 form.
+func (isbn ISBN) Value() (driver.Value, error) {
+	text, err := isbn.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return string(text), nil
+}
+
+// Scan method implements sql.Scanner by delegating to NewISBN.
+func (isbn *ISBN) Scan(src any) error {
+	switch value := src.(type) {
+	case nil:
+		*isbn = ISBN{}
+		return nil
+	case string:
+		return isbn.UnmarshalText([]byte(value))
+	case []byte:
+		return isbn.UnmarshalText(value)
+	default:
+		return fmt.Errorf("isbn: cannot scan %T into ISBN", src)
+	}
+}
@@ -0,0 +1,79 @@
+package isbn
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestISBN_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(isbn13)
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if string(data) != `"ISBN 978-0-7777-7777-0"` {
+		t.Errorf("expected: %v, got: %v", `"ISBN 978-0-7777-7777-0"`, string(data))
+	}
+
+	data, err = json.Marshal(isbn10)
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if string(data) != `"ISBN 978-0-393-04002-9"` {
+		t.Errorf("expected: %v, got: %v", `"ISBN 978-0-393-04002-9"`, string(data))
+	}
+}
+
+func TestISBN_UnmarshalJSON(t *testing.T) {
+	var isbn ISBN
+	if err := json.Unmarshal([]byte(`"978-0-7777-7777-0"`), &isbn); err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if !isbn.IsValid() {
+		t.Error("expected unmarshalled ISBN to be valid")
+	}
+
+	if err := json.Unmarshal([]byte(`"not an isbn"`), &isbn); err == nil {
+		t.Error("expected an error unmarshalling an invalid ISBN")
+	}
+}
+
+func TestISBN_Value(t *testing.T) {
+	value, err := isbn13.Value()
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if value != "ISBN 978-0-7777-7777-0" {
+		t.Errorf("expected: %v, got: %v", "ISBN 978-0-7777-7777-0", value)
+	}
+}
+
+func TestISBN_Scan(t *testing.T) {
+	var isbn ISBN
+	if err := isbn.Scan("978-0-7777-7777-0"); err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if !isbn.IsValid() {
+		t.Error("expected scanned ISBN to be valid")
+	}
+
+	if err := isbn.Scan([]byte("0-393-04002-X")); err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if !isbn.IsValid() {
+		t.Error("expected scanned ISBN to be valid")
+	}
+
+	if err := isbn.Scan(nil); err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if err := isbn.Scan(42); err == nil {
+		t.Error("expected an error scanning an unsupported type")
+	}
+}
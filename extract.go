@@ -0,0 +1,114 @@
+package isbn
+
+import (
+	"io"
+	"iter"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const thinSpace = " "
+
+// candidateRegex matches runs of digits and X check letters (accepted at any position) that may be
+// separated by hyphens, spaces or thin spaces, optionally preceded by an "ISBN"/"ISBN-10"/
+// "ISBN-13" marker. Being greedy, it always extends across an entire contiguous digit run,
+// which is what lets the length check below reject look-alikes such as phone numbers or
+// dates that happen to sit next to real ISBNs. The marker itself is matched but not
+// captured by the numbered group so it never leaks its own "10"/"13" digits into the
+// extracted candidate.
+var candidateRegex = regexp.MustCompile(
+	`(?i)(?:ISBN(?:-1[03])?:?[\s` + thinSpace + `]*)?([0-9Xx](?:[0-9Xx\-\s` + thinSpace + `]*[0-9Xx])?)`,
+)
+
+// nonISBNCharRegex strips everything but digits and the ISBN-10 check letter from a match,
+// leaving the bare candidate ISBN ready to hand to NewISBN.
+var nonISBNCharRegex = regexp.MustCompile(`[^0-9Xx]`)
+
+// FindAll function extracts every valid ISBN found in text, deduplicated. Candidates that
+// are the right shape (10 or 13 digits, optionally hyphenated, optionally marked with an
+// "ISBN" prefix) but fail the checksum are silently dropped, as are runs of digits that
+// belong to adjacent phone numbers or dates rather than an ISBN.
+func FindAll(text string) []ISBN {
+	text = joinWrappedHyphenation(text)
+
+	var found []ISBN
+	seen := make(map[string]struct{})
+
+	for _, loc := range candidateRegex.FindAllStringSubmatchIndex(text, -1) {
+		start, end := loc[2], loc[3]
+		if precededByDigit(text, start) || followedByDigit(text, end) {
+			continue
+		}
+
+		digits := strings.ToUpper(nonISBNCharRegex.ReplaceAllString(text[start:end], ""))
+		if len(digits) != 10 && len(digits) != 13 {
+			continue
+		}
+
+		if _, ok := seen[digits]; ok {
+			continue
+		}
+
+		candidate := NewISBN(digits)
+		if !candidate.IsValid() {
+			continue
+		}
+
+		seen[digits] = struct{}{}
+		found = append(found, candidate)
+	}
+
+	return found
+}
+
+// Scan function reads r in full and yields every valid ISBN found in it, in the order
+// encountered, deduplicated. See FindAll for the extraction rules.
+func Scan(r io.Reader) iter.Seq[ISBN] {
+	return func(yield func(ISBN) bool) {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return
+		}
+
+		for _, found := range FindAll(string(data)) {
+			if !yield(found) {
+				return
+			}
+		}
+	}
+}
+
+// joinWrappedHyphenation collapses line breaks introduced by word-wrapping so an ISBN split
+// across lines - with or without a hyphen at the break - is seen as a single candidate.
+func joinWrappedHyphenation(text string) string {
+	text = strings.ReplaceAll(text, "-\r\n", "-")
+	text = strings.ReplaceAll(text, "-\n", "-")
+	text = strings.ReplaceAll(text, "\r\n", " ")
+	text = strings.ReplaceAll(text, "\n", " ")
+
+	return text
+}
+
+// precededByDigit reports whether the rune right before idx is a digit, meaning the match
+// starting at idx was cut out of a longer digit run rather than starting on its own.
+func precededByDigit(text string, idx int) bool {
+	if idx == 0 {
+		return false
+	}
+
+	r, _ := utf8.DecodeLastRuneInString(text[:idx])
+	return unicode.IsDigit(r)
+}
+
+// followedByDigit reports whether the rune right after idx is a digit, meaning the match
+// ending at idx was cut short of a longer digit run rather than ending on its own.
+func followedByDigit(text string, idx int) bool {
+	if idx >= len(text) {
+		return false
+	}
+
+	r, _ := utf8.DecodeRuneInString(text[idx:])
+	return unicode.IsDigit(r)
+}
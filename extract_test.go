@@ -0,0 +1,63 @@
+package isbn
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFindAll(t *testing.T) {
+	text := "Here are some references:\n" +
+		"ISBN 978-0-7777-7777-0 is a great book.\n" +
+		"Also see ISBN-10 0-393-04002-X for details.\n" +
+		"A phone number 1234567890123 should not match.\n" +
+		"A wrapped one: ISBN 978-0-393-\n" +
+		"04002-9\n" +
+		"Duplicate: 9780777777770\n"
+
+	found := FindAll(text)
+	if len(found) != 3 {
+		t.Fatalf("expected 3 unique valid ISBNs, got %d: %+v", len(found), found)
+	}
+
+	if found[0].BarCode() != "9780777777770" {
+		t.Errorf("expected: %v, got: %v", "9780777777770", found[0].BarCode())
+	}
+
+	if found[1].BarCode() != "039304002X" {
+		t.Errorf("expected: %v, got: %v", "039304002X", found[1].BarCode())
+	}
+
+	if found[2].BarCode() != "9780393040029" {
+		t.Errorf("expected: %v, got: %v", "9780393040029", found[2].BarCode())
+	}
+}
+
+func TestFindAll_ISBNMarkerDigitsNotConcatenated(t *testing.T) {
+	if found := FindAll("Also see ISBN-10 0-393-04002-X for details."); len(found) != 1 {
+		t.Fatalf("expected 1 valid ISBN, got %d: %+v", len(found), found)
+	}
+
+	if found := FindAll("Also see ISBN-13: 9780393040029 for details."); len(found) != 1 {
+		t.Fatalf("expected 1 valid ISBN, got %d: %+v", len(found), found)
+	}
+}
+
+func TestFindAll_RejectsInvalidLookalikes(t *testing.T) {
+	found := FindAll("call 555-1234567 or 1234567890123 for details")
+	if len(found) != 0 {
+		t.Errorf("expected no ISBNs, got: %+v", found)
+	}
+}
+
+func TestScan(t *testing.T) {
+	r := strings.NewReader("ISBN 978-0-7777-7777-0 and 0-393-04002-X")
+
+	var found []ISBN
+	for isbn := range Scan(r) {
+		found = append(found, isbn)
+	}
+
+	if len(found) != 2 {
+		t.Fatalf("expected 2 ISBNs, got %d: %+v", len(found), found)
+	}
+}
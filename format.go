@@ -0,0 +1,53 @@
+package isbn
+
+import (
+	"errors"
+	"strings"
+)
+
+var errInvalidGroups = errors.New("group widths must be positive and sum to the ISBN length")
+
+// Format method hyphenates the barcode digits of isbn into the segment widths given by
+// groups, ignoring the parsed registrationGroup/registrant/publication segmentation. This is
+// useful for a display format a caller requires (e.g. "978-0-393-04002-9" vs the EAN grouping
+// "9-780393-040029" vs "978-0393-04002-9") that doesn't line up with how the ISBN was
+// originally segmented. groups must be positive and sum to 10 for an ISBN-10 (the last
+// segment then covers the trailing check digit, including the "X") or 13 for an ISBN-13.
+func (isbn ISBN) Format(groups ...int) (string, error) {
+	if isbn.err != nil {
+		return "", isbn.err
+	}
+
+	var length int
+	switch isbn.version {
+	case Version10:
+		length = 10
+	case Version13:
+		length = 13
+	default:
+		return "", errWrongISBN
+	}
+
+	sum := 0
+	for _, g := range groups {
+		if g <= 0 {
+			return "", errInvalidGroups
+		}
+
+		sum += g
+	}
+
+	if sum != length {
+		return "", errInvalidGroups
+	}
+
+	barcode := isbn.BarCode()
+	segments := make([]string, 0, len(groups))
+	idx := 0
+	for _, g := range groups {
+		segments = append(segments, barcode[idx:idx+g])
+		idx += g
+	}
+
+	return strings.Join(segments, "-"), nil
+}
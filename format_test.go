@@ -0,0 +1,38 @@
+package isbn
+
+import "testing"
+
+func TestISBN_Format(t *testing.T) {
+	got, err := isbn13.Format(1, 3, 5, 3, 1)
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if got != "9-780-77777-777-0" {
+		t.Errorf("expected: %v, got: %v", "9-780-77777-777-0", got)
+	}
+
+	got, err = isbn10.Format(1, 3, 5, 1)
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if got != "0-393-04002-X" {
+		t.Errorf("expected: %v, got: %v", "0-393-04002-X", got)
+	}
+}
+
+func TestISBN_Format_InvalidGroups(t *testing.T) {
+	if _, err := isbn13.Format(1, 3, 5, 3); err == nil {
+		t.Error("expected an error when group widths don't sum to 13")
+	}
+
+	if _, err := isbn13.Format(1, 3, 5, 3, -1, 2); err == nil {
+		t.Error("expected an error for a non-positive group width")
+	}
+
+	invalid := ISBN{err: errWrongISBN}
+	if _, err := invalid.Format(1, 2); err == nil {
+		t.Error("expected the status error to be returned")
+	}
+}
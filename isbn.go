@@ -23,6 +23,12 @@ const (
 	DefaultPrefix = "978"
 )
 
+// EAN.UCC prefixes recognized by the range table.
+const (
+	prefix978 = "978"
+	prefix979 = "979"
+)
+
 const (
 	version10Mod = 11
 	version13Mod = 10
@@ -35,10 +41,12 @@ const (
 )
 
 const (
-	prefixLength     = 3
-	groupLength      = 5
-	registrantLength = 5
-	checkDigitLength = 1
+	prefixLength = 3
+	// rangeWindowLength is the width of the digit window the range table rules are
+	// expressed over, matching the real ISBN-International RangeMessage.xml schema (its
+	// Range values are 7-digit intervals over the digits following the prefix/group).
+	rangeWindowLength = 7
+	checkDigitLength  = 1
 )
 
 const (
@@ -65,7 +73,8 @@ var (
 )
 
 var (
-	errWrongISBN = errors.New("wrong input ISBN format")
+	errWrongISBN      = errors.New("wrong input ISBN format")
+	errNoISBN10Prefix = errors.New("979 prefixed ISBN has no ISBN-10 equivalent")
 )
 
 // ISBN struct defines the core ISBN logic.
@@ -167,6 +176,36 @@ func (isbn *ISBN) Normalize() {
 	isbn.checkDigit = isbn.calculateV13CheckDigit()
 }
 
+// ToISBN10 method converts an ISBN of version 13 into version 10, recalculating the check
+// digit, and is the reverse of Normalize. It does nothing when isbn is already version 10.
+// Since ISBN-10 predates the EAN.UCC "979" prefix, there is no mod-11 equivalent for it and
+// ToISBN10 returns an error in that case.
+func (isbn *ISBN) ToISBN10() error {
+	if isbn.err != nil {
+		return isbn.err
+	}
+
+	if isbn.version == Version10 {
+		return nil
+	}
+
+	if isbn.prefix != DefaultPrefix {
+		return errNoISBN10Prefix
+	}
+
+	isbn.prefix = ""
+	isbn.version = Version10
+	isbn.checkDigit = isbn.calculateV10CheckDigit()
+
+	return nil
+}
+
+// Clone method returns a copy of isbn so that conversions such as Normalize or ToISBN10
+// can be applied without mutating the original.
+func (isbn ISBN) Clone() ISBN {
+	return isbn
+}
+
 // Error method returns status error.
 func (isbn ISBN) Error() error {
 	return isbn.err
@@ -274,15 +313,24 @@ func parseISBN(isbnStr string) (isbn ISBN) {
 	}
 
 	// set versions and potentially correct prefix
-	if isbn.prefix != DefaultPrefix {
-		isbn.prefix = "" // version 10 doesn't have prefix
-		isbn.version = Version10
-	} else {
+	if isbn.prefix == prefix978 || isbn.prefix == prefix979 {
 		idx += prefixLength
 		isbn.version = Version13
+	} else {
+		isbn.prefix = "" // version 10 doesn't have prefix
+		isbn.version = Version10
+	}
+
+	// the range table is always keyed by the EAN.UCC prefix, version 10 ISBNs predate
+	// the EAN system entirely so they're treated as if they carried the 978 prefix
+	eanPrefix := isbn.prefix
+	if isbn.version == Version10 {
+		eanPrefix = DefaultPrefix
 	}
 
-	groupLength := parseGroupLength(parseNumber(isbnStr, idx, groupLength))
+	table := currentRangeTable()
+
+	groupLength := table.groupLength(eanPrefix, uint64(parseNumber(isbnStr, idx, rangeWindowLength)))
 	if groupLength == 0 {
 		isbn.err = errWrongISBN
 		return isbn
@@ -295,7 +343,7 @@ func parseISBN(isbnStr string) (isbn ISBN) {
 
 	idx += groupLength
 
-	registrantLength := parseRegistrantLength(parseNumber(isbnStr, idx, registrantLength))
+	registrantLength := table.registrantLength(eanPrefix, isbn.registrationGroup, uint64(parseNumber(isbnStr, idx, rangeWindowLength)))
 	if registrantLength == 0 {
 		isbn.err = errWrongISBN
 		return isbn
@@ -349,16 +397,30 @@ func weightSum(number string, weight func() int) int {
 	return sum
 }
 
+// parseNumber reads up to length digits of input starting at start and returns them as a
+// number scaled to be length digits wide. When fewer than length digits remain, the
+// available digits are treated as the most significant ones and the value is scaled up
+// accordingly, so the result still lines up with range rules expressed over the full window.
 func parseNumber(input string, start, length int) (sum int) {
-	if len(input) < start+length {
+	available := len(input) - start
+	if available <= 0 {
 		return sum
 	}
 
+	readLength := length
+	if available < readLength {
+		readLength = available
+	}
+
 	mul := 0
-	for i := start + length - 1; i >= start; i, mul = i-1, mul+1 {
+	for i := start + readLength - 1; i >= start; i, mul = i-1, mul+1 {
 		sum += int(input[i]-'0') * int(math.Pow10(mul))
 	}
 
+	if readLength < length {
+		sum *= int(math.Pow10(length - readLength))
+	}
+
 	return sum
 }
 
@@ -369,43 +431,3 @@ func subString(input string, start, length int) (string, error) {
 
 	return input[start : start+length], nil
 }
-
-func parseGroupLength(group int) int {
-	switch {
-	case group < 60000:
-		return 1
-	case group < 70000:
-		return 0
-	case group < 80000:
-		return 1
-	case group < 95000:
-		return 2
-	case group < 99000:
-		return 3
-	case group < 99900:
-		return 4
-	case group < 99999:
-		return 5
-	default:
-		return 0
-	}
-}
-
-func parseRegistrantLength(registrant int) int {
-	switch {
-	case registrant < 20000:
-		return 2
-	case registrant < 50000:
-		return 3
-	case registrant < 89000:
-		return 4
-	case registrant < 95000:
-		return 2
-	case registrant < 99000:
-		return 4
-	case registrant < 100000:
-		return 5
-	default:
-		return 0
-	}
-}
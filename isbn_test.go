@@ -263,6 +263,53 @@ func TestISBN_Normalize(t *testing.T) {
 	}
 }
 
+func TestISBN_ToISBN10(t *testing.T) {
+	original := isbn13
+	isbn := isbn13.Clone()
+
+	if err := isbn.ToISBN10(); err != nil {
+		t.Errorf("error not expected, got: %v", err)
+	}
+
+	if !reflect.DeepEqual(isbn, isbn10Equivalent) {
+		t.Errorf("expected: %+v, got: %+v", isbn10Equivalent, isbn)
+	}
+
+	if !reflect.DeepEqual(isbn13, original) {
+		t.Error("ToISBN10 should not mutate the clone source")
+	}
+
+	isbn979 := ISBN{
+		version:           Version13,
+		prefix:            "979",
+		registrationGroup: "10",
+		registrant:        "12345",
+		publication:       "678",
+		checkDigit:        "9",
+	}
+
+	if err := isbn979.ToISBN10(); err == nil {
+		t.Error("expected error converting a 979 prefixed ISBN to version 10")
+	}
+}
+
+func TestISBN_Clone(t *testing.T) {
+	original := isbn10
+	clone := original.Clone()
+	if !reflect.DeepEqual(clone, original) {
+		t.Errorf("expected clone to equal original, expected: %+v, got: %+v", original, clone)
+	}
+
+	clone.Normalize()
+	if clone.version != Version13 || clone.prefix != DefaultPrefix || clone.checkDigit != "9" {
+		t.Errorf("expected clone.Normalize() to convert the clone to ISBN 978-0-393-04002-9, got: %+v", clone)
+	}
+
+	if original.version != Version10 || original.prefix != "" || original.checkDigit != "X" {
+		t.Errorf("mutating the clone affected the original, got: %+v", original)
+	}
+}
+
 func TestISBN_String(t *testing.T) {
 	if isbn10.String() != "ISBN 0-393-04002-X" {
 		t.Errorf("expected: %v, got: %v", "ISBN 0-393-04002-X", isbn10.String())
@@ -302,3 +349,12 @@ var isbn13 = ISBN{
 	publication:       "7777",
 	checkDigit:        "0",
 }
+
+var isbn10Equivalent = ISBN{
+	version:           Version10,
+	prefix:            "",
+	registrationGroup: "0",
+	registrant:        "7777",
+	publication:       "7777",
+	checkDigit:        "X",
+}
@@ -0,0 +1,174 @@
+package isbn
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+//go:embed rangemessage.xml
+var defaultRangeMessage []byte
+
+var (
+	defaultRangeTableMu sync.RWMutex
+	defaultRangeTable   *RangeTable
+)
+
+func init() {
+	t, err := LoadRangeTable(bytes.NewReader(defaultRangeMessage))
+	if err != nil {
+		panic("isbn: failed to parse embedded rangemessage.xml: " + err.Error())
+	}
+
+	defaultRangeTable = t
+}
+
+// RangeTable holds the EAN.UCC prefix and registration group segmentation rules published
+// by the ISBN International Agency in RangeMessage.xml. NewISBN consults the table
+// installed via SetDefaultRangeTable (the embedded snapshot by default) to work out how
+// many digits belong to the registration group and the registrant of an unhyphenated ISBN.
+type RangeTable struct {
+	// groupRules is keyed by EAN.UCC prefix ("978", "979") and yields the length of the
+	// registration group for a given 7-digit window of the digits that follow the prefix,
+	// matching the Range values of the real RangeMessage.xml.
+	groupRules map[string][]lengthRule
+	// registrantRules is keyed by "<prefix>-<registrationGroup>" (e.g. "978-0") and yields
+	// the length of the registrant for a given 7-digit window of the digits that follow
+	// the registration group.
+	registrantRules map[string][]lengthRule
+}
+
+// lengthRule associates a numeric interval with the segment length it produces.
+type lengthRule struct {
+	lo, hi uint64
+	length int
+}
+
+// rangeMessageXML mirrors the subset of the official RangeMessage.xml schema this library
+// understands: EAN.UCCPrefixes (registration group lengths) and RegistrationGroups
+// (registrant lengths).
+type rangeMessageXML struct {
+	XMLName        xml.Name `xml:"ISBNRangeMessage"`
+	EANUCCPrefixes struct {
+		EANUCC []struct {
+			Prefix string    `xml:"Prefix"`
+			Rules  []ruleXML `xml:"Rules>Rule"`
+		} `xml:"EAN.UCC"`
+	} `xml:"EAN.UCCPrefixes"`
+	RegistrationGroups struct {
+		Group []struct {
+			Prefix string    `xml:"Prefix"`
+			Rules  []ruleXML `xml:"Rules>Rule"`
+		} `xml:"Group"`
+	} `xml:"RegistrationGroups"`
+}
+
+type ruleXML struct {
+	Range  string `xml:"Range"`
+	Length int    `xml:"Length"`
+}
+
+// LoadRangeTable function parses an ISBN International RangeMessage.xml document (or a
+// compatible subset of it) and returns the resulting RangeTable. Install it with
+// SetDefaultRangeTable to make NewISBN use it.
+func LoadRangeTable(r io.Reader) (*RangeTable, error) {
+	var msg rangeMessageXML
+	if err := xml.NewDecoder(r).Decode(&msg); err != nil {
+		return nil, err
+	}
+
+	t := &RangeTable{
+		groupRules:      make(map[string][]lengthRule),
+		registrantRules: make(map[string][]lengthRule),
+	}
+
+	for _, prefix := range msg.EANUCCPrefixes.EANUCC {
+		rules, err := parseRuleXMLs(prefix.Rules)
+		if err != nil {
+			return nil, err
+		}
+
+		t.groupRules[prefix.Prefix] = rules
+	}
+
+	for _, group := range msg.RegistrationGroups.Group {
+		rules, err := parseRuleXMLs(group.Rules)
+		if err != nil {
+			return nil, err
+		}
+
+		t.registrantRules[group.Prefix] = rules
+	}
+
+	return t, nil
+}
+
+// SetDefaultRangeTable function installs t as the RangeTable NewISBN uses to segment
+// registration groups and registrants. Passing nil restores the embedded snapshot.
+func SetDefaultRangeTable(t *RangeTable) {
+	defaultRangeTableMu.Lock()
+	defer defaultRangeTableMu.Unlock()
+
+	if t == nil {
+		t, _ = LoadRangeTable(bytes.NewReader(defaultRangeMessage))
+	}
+
+	defaultRangeTable = t
+}
+
+func currentRangeTable() *RangeTable {
+	defaultRangeTableMu.RLock()
+	defer defaultRangeTableMu.RUnlock()
+
+	return defaultRangeTable
+}
+
+// groupLength returns the registration group length for the given EAN.UCC prefix and the
+// 7-digit window following it, or 0 when the window matches no known agency.
+func (t *RangeTable) groupLength(prefix string, window uint64) int {
+	return matchLength(t.groupRules[prefix], window)
+}
+
+// registrantLength returns the registrant length for the given EAN.UCC prefix, registration
+// group and the 7-digit window following the group, or 0 when nothing matches.
+func (t *RangeTable) registrantLength(prefix, group string, window uint64) int {
+	return matchLength(t.registrantRules[prefix+"-"+group], window)
+}
+
+func matchLength(rules []lengthRule, window uint64) int {
+	for _, rule := range rules {
+		if window >= rule.lo && window <= rule.hi {
+			return rule.length
+		}
+	}
+
+	return 0
+}
+
+func parseRuleXMLs(rules []ruleXML) ([]lengthRule, error) {
+	parsed := make([]lengthRule, 0, len(rules))
+	for _, r := range rules {
+		bounds := strings.SplitN(r.Range, "-", 2)
+		if len(bounds) != 2 {
+			return nil, errWrongISBN
+		}
+
+		lo, err := strconv.ParseUint(bounds[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		hi, err := strconv.ParseUint(bounds[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		parsed = append(parsed, lengthRule{lo: lo, hi: hi, length: r.Length})
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,189 @@
+package isbn
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewISBN_WorldwideGroups(t *testing.T) {
+	tests := []struct {
+		name    string
+		barcode string
+		want    ISBN
+	}{
+		{
+			name:    "978-2 French",
+			barcode: "9782070408504",
+			want: ISBN{
+				version:           Version13,
+				prefix:            "978",
+				registrationGroup: "2",
+				registrant:        "07",
+				publication:       "040850",
+				checkDigit:        "4",
+			},
+		},
+		{
+			name:    "978-3 German",
+			barcode: "9783161484100",
+			want: ISBN{
+				version:           Version13,
+				prefix:            "978",
+				registrationGroup: "3",
+				registrant:        "16",
+				publication:       "148410",
+				checkDigit:        "0",
+			},
+		},
+		{
+			name:    "978-4 Japan",
+			barcode: "9784873118369",
+			want: ISBN{
+				version:           Version13,
+				prefix:            "978",
+				registrationGroup: "4",
+				registrant:        "8731",
+				publication:       "1836",
+				checkDigit:        "9",
+			},
+		},
+		{
+			name:    "978-7 China",
+			barcode: "9787111548423",
+			want: ISBN{
+				version:           Version13,
+				prefix:            "978",
+				registrationGroup: "7",
+				registrant:        "111",
+				publication:       "54842",
+				checkDigit:        "3",
+			},
+		},
+		{
+			name:    "979-8 United States",
+			barcode: "9798106360715",
+			want: ISBN{
+				version:           Version13,
+				prefix:            "979",
+				registrationGroup: "8",
+				registrant:        "106360",
+				publication:       "71",
+				checkDigit:        "5",
+			},
+		},
+		{
+			name:    "979-10 France",
+			barcode: "9791010636075",
+			want: ISBN{
+				version:           Version13,
+				prefix:            "979",
+				registrationGroup: "10",
+				registrant:        "1063",
+				publication:       "607",
+				checkDigit:        "5",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewISBN(tt.barcode)
+			tt.want.originalISBN = tt.barcode
+
+			if !got.IsValid() {
+				t.Errorf("expected %s to be valid, got: %+v", tt.barcode, got)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected: %+v, got: %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLoadRangeTable(t *testing.T) {
+	t.Run("malformed XML", func(t *testing.T) {
+		if _, err := LoadRangeTable(strings.NewReader("<not-xml")); err == nil {
+			t.Error("expected an error for malformed XML")
+		}
+	})
+
+	t.Run("malformed range bounds", func(t *testing.T) {
+		doc := `<ISBNRangeMessage>
+			<EAN.UCCPrefixes>
+				<EAN.UCC>
+					<Prefix>978</Prefix>
+					<Rules>
+						<Rule>
+							<Range>not-a-range</Range>
+							<Length>1</Length>
+						</Rule>
+					</Rules>
+				</EAN.UCC>
+			</EAN.UCCPrefixes>
+		</ISBNRangeMessage>`
+
+		if _, err := LoadRangeTable(strings.NewReader(doc)); err == nil {
+			t.Error("expected an error for a malformed range bound")
+		}
+	})
+
+	t.Run("custom table overrides segmentation", func(t *testing.T) {
+		doc := `<ISBNRangeMessage>
+			<EAN.UCCPrefixes>
+				<EAN.UCC>
+					<Prefix>978</Prefix>
+					<Rules>
+						<Rule>
+							<Range>0000000-9999999</Range>
+							<Length>1</Length>
+						</Rule>
+					</Rules>
+				</EAN.UCC>
+			</EAN.UCCPrefixes>
+			<RegistrationGroups>
+				<Group>
+					<Prefix>978-0</Prefix>
+					<Rules>
+						<Rule>
+							<Range>0000000-9999999</Range>
+							<Length>1</Length>
+						</Rule>
+					</Rules>
+				</Group>
+			</RegistrationGroups>
+		</ISBNRangeMessage>`
+
+		custom, err := LoadRangeTable(strings.NewReader(doc))
+		if err != nil {
+			t.Fatalf("error not expected, got: %v", err)
+		}
+
+		SetDefaultRangeTable(custom)
+		defer SetDefaultRangeTable(nil)
+
+		got := NewISBN("9780777777770")
+		if got.registrant != "7" || got.publication != "7777777" {
+			t.Errorf("expected the custom table to be used, got: %+v", got)
+		}
+	})
+
+	t.Run("restoring the default via nil", func(t *testing.T) {
+		SetDefaultRangeTable(nil)
+
+		got := NewISBN("9780777777770")
+		want := isbn13
+		want.originalISBN = "9780777777770"
+
+		if !reflect.DeepEqual(got, want) {
+			t.Error("expected SetDefaultRangeTable(nil) to restore the embedded default table")
+		}
+	})
+
+	t.Run("embedded default parses on init", func(t *testing.T) {
+		if currentRangeTable() == nil {
+			t.Fatal("expected the embedded rangemessage.xml to have been loaded on init")
+		}
+	})
+}
@@ -0,0 +1,36 @@
+// Package validate adapts ISBN validation to github.com/go-playground/validator/v10, so
+// consumers that want struct-tag validation can opt into the dependency without it being
+// pulled in by the base isbn package.
+package validate
+
+import (
+	"github.com/go-playground/validator/v10"
+
+	"github.com/lukasaron/isbn"
+)
+
+// ValidateISBN function is a validator.Func adapter reporting whether a struct field holds
+// a valid ISBN of either version. Register it against a tag of choice, e.g.:
+//
+//	v := validator.New()
+//	v.RegisterValidation("isbn", validate.ValidateISBN)
+//	type Book struct {
+//		ISBN string `validate:"isbn"`
+//	}
+func ValidateISBN(fl validator.FieldLevel) bool {
+	return isbn.NewISBN(fl.Field().String()).IsValid()
+}
+
+// ValidateISBN10 function is a validator.Func adapter reporting whether a struct field
+// holds a valid ISBN-10. Register it the same way as ValidateISBN.
+func ValidateISBN10(fl validator.FieldLevel) bool {
+	candidate := isbn.NewISBN(fl.Field().String())
+	return candidate.Version() == isbn.Version10 && candidate.IsValid()
+}
+
+// ValidateISBN13 function is a validator.Func adapter reporting whether a struct field
+// holds a valid ISBN-13. Register it the same way as ValidateISBN.
+func ValidateISBN13(fl validator.FieldLevel) bool {
+	candidate := isbn.NewISBN(fl.Field().String())
+	return candidate.Version() == isbn.Version13 && candidate.IsValid()
+}
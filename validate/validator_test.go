@@ -0,0 +1,46 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type book struct {
+	ISBN10 string `validate:"isbn10"`
+	ISBN13 string `validate:"isbn13"`
+	ISBN   string `validate:"isbn"`
+}
+
+func TestValidate(t *testing.T) {
+	v := validator.New()
+	if err := v.RegisterValidation("isbn10", ValidateISBN10); err != nil {
+		t.Fatal(err)
+	}
+	if err := v.RegisterValidation("isbn13", ValidateISBN13); err != nil {
+		t.Fatal(err)
+	}
+	if err := v.RegisterValidation("isbn", ValidateISBN); err != nil {
+		t.Fatal(err)
+	}
+
+	valid := book{
+		ISBN10: "0-393-04002-X",
+		ISBN13: "978-0-7777-7777-0",
+		ISBN:   "978-0-7777-7777-0",
+	}
+
+	if err := v.Struct(valid); err != nil {
+		t.Errorf("error not expected, got: %v", err)
+	}
+
+	invalid := book{
+		ISBN10: "978-0-7777-7777-0", // not a version 10 ISBN
+		ISBN13: "0-393-04002-X",     // not a version 13 ISBN
+		ISBN:   "not an isbn",
+	}
+
+	if err := v.Struct(invalid); err == nil {
+		t.Error("expected a validation error")
+	}
+}